@@ -0,0 +1,12 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package common
+
+// ClientOptions holds configuration shared by the Microsoft Graph clients used across the provider.
+type ClientOptions struct {
+	// BatchOperationsEnabled toggles the use of Graph's $batch endpoint when reconciling authentication
+	// strength combinationConfigurations. When it is false, each combinationConfiguration is created, updated
+	// or deleted with its own request, which is easier to trace. Resolving policy usage does not use $batch.
+	BatchOperationsEnabled bool
+}
@@ -0,0 +1,159 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conditionalaccess
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/validate"
+)
+
+func authenticationStrengthPolicyDataSource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: authenticationStrengthPolicyDataSourceRead,
+
+		Schema: map[string]*schema.Schema{
+			"object_id": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: validate.UUID,
+				ExactlyOneOf:     []string{"object_id", "display_name"},
+			},
+
+			"display_name": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: validate.NoEmptyStrings,
+				ExactlyOneOf:     []string{"object_id", "display_name"},
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"policy_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"requirements_satisfied": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"allowed_combinations": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"x509_certificate_combination_configuration": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"applies_to_combinations": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+
+						"allowed_issuer_skis": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+
+						"allowed_policy_oids": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func authenticationStrengthPolicyDataSourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*clients.Client).ConditionalAccess.AuthenticationStrengthPoliciesClient
+
+	var policyId string
+
+	if v, ok := d.GetOk("object_id"); ok {
+		policyId = v.(string)
+	} else {
+		displayName := d.Get("display_name").(string)
+
+		policies, _, err := client.List(ctx, odata.Query{
+			Filter: fmt.Sprintf("displayName eq '%s'", odata.EscapeSingleQuote(displayName)),
+		})
+		if err != nil {
+			return tf.ErrorDiagF(err, "Listing authentication strength policies")
+		}
+		if policies == nil {
+			return tf.ErrorDiagF(fmt.Errorf("Bad API response"), "Result is nil")
+		}
+
+		count := len(*policies)
+		if count == 0 {
+			return tf.ErrorDiagPathF(nil, "display_name", "No authentication strength policy found matching display name: %q", displayName)
+		}
+		if count > 1 {
+			return tf.ErrorDiagPathF(nil, "display_name", "More than one authentication strength policy found matching display name: %q", displayName)
+		}
+
+		policy := (*policies)[0]
+		if policy.ID == nil || *policy.ID == "" {
+			return tf.ErrorDiagF(fmt.Errorf("Bad API response"), "Object ID returned for authentication strength policy is nil/empty")
+		}
+		policyId = *policy.ID
+	}
+
+	policy, status, err := client.Get(ctx, policyId, odata.Query{})
+	if err != nil {
+		if status == 404 {
+			return tf.ErrorDiagPathF(nil, "object_id", "No authentication strength policy found with ID: %q", policyId)
+		}
+		return tf.ErrorDiagF(err, "Retrieving authentication strength policy with ID %q", policyId)
+	}
+	if policy == nil {
+		return tf.ErrorDiagF(fmt.Errorf("Bad API response"), "Result is nil")
+	}
+
+	d.SetId(policyId)
+
+	tf.Set(d, "object_id", policyId)
+	tf.Set(d, "display_name", policy.DisplayName)
+	tf.Set(d, "description", policy.Description)
+	tf.Set(d, "policy_type", policy.PolicyType)
+	tf.Set(d, "requirements_satisfied", policy.RequirementsSatisfied)
+	tf.Set(d, "allowed_combinations", flattenAuthenticationStrengthAllowedCombinations(policy.AllowedCombinations))
+
+	configurations, _, err := client.ListCombinationConfigurations(ctx, policyId, odata.Query{})
+	if err != nil {
+		return tf.ErrorDiagF(err, "Listing combination configurations for authentication strength policy with ID %q", policyId)
+	}
+	tf.Set(d, "x509_certificate_combination_configuration", flattenX509CertificateCombinationConfigurations(configurations))
+
+	return nil
+}
@@ -0,0 +1,62 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conditionalaccess_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+)
+
+type AuthenticationStrengthPolicyDataSource struct{}
+
+func TestAccAuthenticationStrengthPolicyDataSource_byBuiltInDisplayName(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_authentication_strength_policy", "test")
+	r := AuthenticationStrengthPolicyDataSource{}
+
+	data.DataSourceTest(t, []resource.TestStep{
+		{
+			Config: r.byDisplayName("Multifactor authentication"),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("policy_type").HasValue("builtIn"),
+				check.That(data.ResourceName).Key("allowed_combinations.#").Exists(),
+			),
+		},
+	})
+}
+
+func TestAccAuthenticationStrengthPolicyDataSource_byObjectId(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_authentication_strength_policy", "test")
+	r := AuthenticationStrengthPolicyDataSource{}
+
+	data.DataSourceTest(t, []resource.TestStep{
+		{
+			Config: r.byObjectId(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("display_name").HasValue(fmt.Sprintf("acctest-AuthStrength-%d", data.RandomInteger)),
+			),
+		},
+	})
+}
+
+func (AuthenticationStrengthPolicyDataSource) byDisplayName(displayName string) string {
+	return fmt.Sprintf(`
+data "azuread_authentication_strength_policy" "test" {
+  display_name = %q
+}
+`, displayName)
+}
+
+func (AuthenticationStrengthPolicyDataSource) byObjectId(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+data "azuread_authentication_strength_policy" "test" {
+  object_id = azuread_authentication_strength_policy.test.id
+}
+`, AuthenticationStrengthPolicyResource{}.basic(data))
+}
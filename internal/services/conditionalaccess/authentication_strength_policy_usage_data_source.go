@@ -0,0 +1,55 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conditionalaccess
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/validate"
+)
+
+func authenticationStrengthPolicyUsageDataSource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: authenticationStrengthPolicyUsageDataSourceRead,
+
+		Schema: map[string]*schema.Schema{
+			"authentication_strength_policy_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validate.UUID,
+			},
+
+			"used_by_conditional_access_policies": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     authenticationStrengthUsageElem(),
+			},
+		},
+	}
+}
+
+func authenticationStrengthPolicyUsageDataSourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	policyId := d.Get("authentication_strength_policy_id").(string)
+
+	authStrengthClient := meta.(*clients.Client).ConditionalAccess.AuthenticationStrengthPoliciesClient
+	if _, _, err := authStrengthClient.Get(ctx, policyId, odata.Query{}); err != nil {
+		return tf.ErrorDiagPathF(err, "authentication_strength_policy_id", "Retrieving authentication strength policy with ID %q", policyId)
+	}
+
+	policiesClient := meta.(*clients.Client).ConditionalAccess.PoliciesClient
+	usage, err := listAuthenticationStrengthPolicyUsage(ctx, policiesClient, policyId)
+	if err != nil {
+		return tf.ErrorDiagF(err, "Listing conditional access policies using authentication strength policy with ID %q", policyId)
+	}
+
+	d.SetId(policyId)
+	tf.Set(d, "used_by_conditional_access_policies", usage)
+
+	return nil
+}
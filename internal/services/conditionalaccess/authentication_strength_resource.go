@@ -9,14 +9,15 @@ import (
 	"fmt"
 	"log"
 	"net/http"
-	"reflect"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/go-azure-sdk/sdk/odata"
 	"github.com/hashicorp/go-uuid"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
 	"github.com/hashicorp/terraform-provider-azuread/internal/helpers"
 	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
@@ -25,6 +26,33 @@ import (
 	"github.com/manicminer/hamilton/msgraph"
 )
 
+// x509CombinationModes are the allowedCombinations values that a combinationConfiguration may be scoped to.
+var x509CombinationModes = []string{
+	"x509CertificateSingleFactor",
+	"x509CertificateMultiFactor",
+}
+
+// authenticationMethodModes are the values Microsoft Graph currently accepts in an
+// authenticationStrengthPolicy's allowedCombinations collection.
+var authenticationMethodModes = []string{
+	"password",
+	"voice",
+	"hardwareOath",
+	"softwareOath",
+	"sms",
+	"fido2",
+	"windowsHelloForBusiness",
+	"microsoftAuthenticatorPush",
+	"deviceBasedPush",
+	"temporaryAccessPassOneTime",
+	"temporaryAccessPassMultiUse",
+	"email",
+	"x509CertificateSingleFactor",
+	"x509CertificateMultiFactor",
+	"federatedSingleFactor",
+	"federatedMultiFactor",
+}
+
 func authenticationStrengthResource() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: authenticationStrengthResourceCreate,
@@ -47,7 +75,6 @@ func authenticationStrengthResource() *schema.Resource {
 		}),
 
 		Schema: map[string]*schema.Schema{
-
 			"display_name": {
 				Type:             schema.TypeString,
 				Required:         true,
@@ -56,15 +83,69 @@ func authenticationStrengthResource() *schema.Resource {
 
 			"description": {
 				Type:             schema.TypeString,
-				Required:         true,
+				Optional:         true,
 				ValidateDiagFunc: validate.NoEmptyStrings,
 			},
 
 			"allowed_combinations": {
-				Type:     schema.TypeList,
+				Type:     schema.TypeSet,
 				Required: true,
+				MinItems: 1,
 				Elem: &schema.Schema{
-					Type: schema.TypeString,
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(authenticationMethodModes, false),
+				},
+			},
+
+			"policy_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"requirements_satisfied": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"used_by_conditional_access_policies": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     authenticationStrengthUsageElem(),
+			},
+
+			"x509_certificate_combination_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"applies_to_combinations": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringInSlice(x509CombinationModes, false),
+							},
+						},
+
+						"allowed_issuer_skis": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:             schema.TypeString,
+								ValidateDiagFunc: validate.NoEmptyStrings,
+							},
+						},
+
+						"allowed_policy_oids": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:             schema.TypeString,
+								ValidateDiagFunc: validate.NoEmptyStrings,
+							},
+						},
+					},
 				},
 			},
 		},
@@ -72,226 +153,299 @@ func authenticationStrengthResource() *schema.Resource {
 }
 
 func authenticationStrengthResourceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*clients.Client).ConditionalAccess.authenticationStrengthClient
+	client := meta.(*clients.Client).ConditionalAccess.AuthenticationStrengthPoliciesClient
 
 	displayName := d.Get("display_name").(string)
 	description := d.Get("description").(string)
+	allowedCombinations := expandAuthenticationStrengthAllowedCombinations(d.Get("allowed_combinations").(*schema.Set).List())
 
-	if v, ok := d.GetOk("ip"); ok {
-		properties := expandIPauthenticationStrength(v.([]interface{}))
-		properties.BaseauthenticationStrength = &base
+	properties := msgraph.AuthenticationStrengthPolicy{
+		DisplayName:         &displayName,
+		AllowedCombinations: &allowedCombinations,
+	}
+	if description != "" {
+		properties.Description = &description
+	}
 
-		ipLocation, _, err := client.CreateIP(ctx, *properties)
-		if err != nil {
-			return tf.ErrorDiagF(err, "Could not create named location")
+	policy, _, err := client.Create(ctx, properties)
+	if err != nil {
+		return tf.ErrorDiagF(err, "Could not create authentication strength policy")
+	}
+	if policy.ID == nil || *policy.ID == "" {
+		return tf.ErrorDiagF(errors.New("Bad API response"), "Object ID returned for authentication strength policy is nil/empty")
+	}
+
+	d.SetId(*policy.ID)
+
+	desiredConfigurations := expandX509CertificateCombinationConfigurations(d.Get("x509_certificate_combination_configuration").([]interface{}))
+	batchOperationsEnabled := meta.(*clients.Client).ConditionalAccess.BatchOperationsEnabled
+	if err := reconcileCombinationConfigurations(ctx, client, *policy.ID, desiredConfigurations, batchOperationsEnabled); err != nil {
+		return tf.ErrorDiagF(err, "Could not set combination configurations for authentication strength policy with ID %q", *policy.ID)
+	}
+
+	return authenticationStrengthResourceRead(ctx, d, meta)
+}
+
+func authenticationStrengthResourceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*clients.Client).ConditionalAccess.AuthenticationStrengthPoliciesClient
+	id := d.Id()
+
+	displayNameChanged := d.HasChange("display_name")
+	descriptionChanged := d.HasChange("description")
+	allowedCombinationsChanged := d.HasChange("allowed_combinations")
+
+	if displayNameChanged || descriptionChanged {
+		// Graph's PATCH for authenticationStrengthPolicy only accepts displayName/description; it rejects
+		// a payload that also carries allowedCombinations, so that field is never folded in here.
+		properties := msgraph.AuthenticationStrengthPolicy{
+			ID: &id,
 		}
-		if ipLocation.ID == nil || *ipLocation.ID == "" {
-			return tf.ErrorDiagF(errors.New("Bad API response"), "Object ID returned for named location is nil/empty")
+
+		if displayNameChanged {
+			displayName := d.Get("display_name").(string)
+			properties.DisplayName = &displayName
 		}
 
-		d.SetId(*ipLocation.ID)
-	} else if v, ok := d.GetOk("country"); ok {
-		properties := expandCountryauthenticationStrength(v.([]interface{}))
-		properties.BaseauthenticationStrength = &base
+		if descriptionChanged {
+			description := d.Get("description").(string)
+			properties.Description = &description
+		}
 
-		countryLocation, _, err := client.CreateCountry(ctx, *properties)
-		if err != nil {
-			return tf.ErrorDiagF(err, "Could not create named location")
+		if _, err := client.Update(ctx, properties); err != nil {
+			return tf.ErrorDiagF(err, "Could not update authentication strength policy with ID %q", id)
 		}
-		if countryLocation.ID == nil || *countryLocation.ID == "" {
-			return tf.ErrorDiagF(errors.New("Bad API response"), "Object ID returned for named location is nil/empty")
+	}
+
+	if allowedCombinationsChanged {
+		// allowedCombinations always goes through the dedicated updateAllowedCombinations action,
+		// regardless of whether displayName/description also changed in this apply.
+		allowedCombinations := expandAuthenticationStrengthAllowedCombinations(d.Get("allowed_combinations").(*schema.Set).List())
+		if _, _, err := client.UpdateAllowedCombinations(ctx, id, allowedCombinations); err != nil {
+			return tf.ErrorDiagF(err, "Could not update allowed combinations for authentication strength policy with ID %q", id)
 		}
+	}
 
-		d.SetId(*countryLocation.ID)
-	} else {
-		return tf.ErrorDiagF(errors.New("one of `ip` or `country` must be specified"), "Unable to determine named location type")
+	if d.HasChange("x509_certificate_combination_configuration") {
+		desiredConfigurations := expandX509CertificateCombinationConfigurations(d.Get("x509_certificate_combination_configuration").([]interface{}))
+		batchOperationsEnabled := meta.(*clients.Client).ConditionalAccess.BatchOperationsEnabled
+		if err := reconcileCombinationConfigurations(ctx, client, id, desiredConfigurations, batchOperationsEnabled); err != nil {
+			return tf.ErrorDiagF(err, "Could not reconcile combination configurations for authentication strength policy with ID %q", id)
+		}
 	}
 
 	return authenticationStrengthResourceRead(ctx, d, meta)
 }
 
-func authenticationStrengthResourceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*clients.Client).ConditionalAccess.authenticationStrengthClient
+func authenticationStrengthResourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*clients.Client).ConditionalAccess.AuthenticationStrengthPoliciesClient
 
-	base := msgraph.BaseauthenticationStrength{
-		ID: utils.String(d.Id()),
+	policy, status, err := client.Get(ctx, d.Id(), odata.Query{})
+	if err != nil {
+		if status == http.StatusNotFound {
+			log.Printf("[DEBUG] Authentication Strength Policy with Object ID %q was not found - removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return tf.ErrorDiagF(err, "Retrieving authentication strength policy with ID %q", d.Id())
+	}
+	if policy == nil {
+		return tf.ErrorDiagF(errors.New("Bad API response"), "Result is nil")
 	}
 
-	if d.HasChange("display_name") {
-		displayName := d.Get("display_name").(string)
-		base.DisplayName = &displayName
+	tf.Set(d, "display_name", policy.DisplayName)
+	tf.Set(d, "description", policy.Description)
+	tf.Set(d, "allowed_combinations", flattenAuthenticationStrengthAllowedCombinations(policy.AllowedCombinations))
+	tf.Set(d, "policy_type", policy.PolicyType)
+	tf.Set(d, "requirements_satisfied", policy.RequirementsSatisfied)
+
+	configurations, _, err := client.ListCombinationConfigurations(ctx, d.Id(), odata.Query{})
+	if err != nil {
+		return tf.ErrorDiagF(err, "Listing combination configurations for authentication strength policy with ID %q", d.Id())
 	}
+	tf.Set(d, "x509_certificate_combination_configuration", flattenX509CertificateCombinationConfigurations(configurations))
 
-	var updateRefreshFunc resource.StateRefreshFunc //nolint:staticcheck
+	policiesClient := meta.(*clients.Client).ConditionalAccess.PoliciesClient
+	usage, err := listAuthenticationStrengthPolicyUsage(ctx, policiesClient, d.Id())
+	if err != nil {
+		return tf.ErrorDiagF(err, "Listing conditional access policies using authentication strength policy with ID %q", d.Id())
+	}
+	tf.Set(d, "used_by_conditional_access_policies", usage)
 
-	if v, ok := d.GetOk("ip"); ok {
-		properties := expandIPauthenticationStrength(v.([]interface{}))
-		properties.BaseauthenticationStrength = &base
+	return nil
+}
 
-		if _, err := client.UpdateIP(ctx, *properties); err != nil {
-			return tf.ErrorDiagF(err, "Could not update named location with ID %q: %+v", d.Id(), err)
-		}
+func authenticationStrengthResourceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*clients.Client).ConditionalAccess.AuthenticationStrengthPoliciesClient
+	id := d.Id()
 
-		updateRefreshFunc = func() (interface{}, string, error) {
-			result, _, err := client.GetIP(ctx, d.Id(), odata.Query{})
-			if err != nil {
-				return nil, "Error", err
-			}
+	if status, err := client.Delete(ctx, id); err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Deleting authentication strength policy with ID %q, got status %d", id, status)
+	}
 
-			if locationRaw := flattenIPauthenticationStrength(result); len(locationRaw) > 0 {
-				location := locationRaw[0].(map[string]interface{})
-				ip := v.([]interface{})[0].(map[string]interface{})
-				if !reflect.DeepEqual(location["ip_ranges"], ip["ip_ranges"]) {
-					return "stub", "Pending", nil
-				}
-				if location["trusted"].(bool) != ip["trusted"].(bool) {
-					return "stub", "Pending", nil
-				}
+	if err := helpers.WaitForDeletion(ctx, func(ctx context.Context) (*bool, error) {
+		defer func() { client.BaseClient.DisableRetries = false }()
+		client.BaseClient.DisableRetries = true
+		if _, status, err := client.Get(ctx, id, odata.Query{}); err != nil {
+			if status == http.StatusNotFound {
+				return utils.Bool(false), nil
 			}
-
-			return "stub", "Updated", nil
+			return nil, err
 		}
+		return utils.Bool(true), nil
+	}); err != nil {
+		return tf.ErrorDiagF(err, "waiting for deletion of authentication strength policy with ID %q", id)
 	}
 
-	if v, ok := d.GetOk("country"); ok {
-		properties := expandCountryauthenticationStrength(v.([]interface{}))
-		properties.BaseauthenticationStrength = &base
+	return nil
+}
 
-		if _, err := client.UpdateCountry(ctx, *properties); err != nil {
-			return tf.ErrorDiagF(err, "Could not update named location with ID %q: %+v", d.Id(), err)
-		}
+func expandAuthenticationStrengthAllowedCombinations(input []interface{}) []string {
+	result := make([]string, 0, len(input))
+	for _, v := range input {
+		result = append(result, v.(string))
+	}
+	return result
+}
 
-		updateRefreshFunc = func() (interface{}, string, error) {
-			result, _, err := client.GetCountry(ctx, d.Id(), odata.Query{})
-			if err != nil {
-				return nil, "Error", err
-			}
+func flattenAuthenticationStrengthAllowedCombinations(input *[]string) []string {
+	if input == nil {
+		return []string{}
+	}
+	return *input
+}
 
-			if locationRaw := flattenCountryauthenticationStrength(result); len(locationRaw) > 0 {
-				location := locationRaw[0].(map[string]interface{})
-				ip := v.([]interface{})[0].(map[string]interface{})
-				if !reflect.DeepEqual(location["countries_and_regions"], ip["countries_and_regions"]) {
-					return "stub", "Pending", nil
-				}
-				if location["include_unknown_countries_and_regions"].(bool) != ip["include_unknown_countries_and_regions"].(bool) {
-					return "stub", "Pending", nil
-				}
-			}
+func expandX509CertificateCombinationConfigurations(input []interface{}) []msgraph.X509CertificateCombinationConfiguration {
+	result := make([]msgraph.X509CertificateCombinationConfiguration, 0, len(input))
+	for _, raw := range input {
+		config := raw.(map[string]interface{})
 
-			return "stub", "Updated", nil
-		}
+		appliesTo := expandAuthenticationStrengthAllowedCombinations(config["applies_to_combinations"].(*schema.Set).List())
+		allowedIssuerSkis := expandStringList(config["allowed_issuer_skis"].(*schema.Set).List())
+		allowedPolicyOIDs := expandStringList(config["allowed_policy_oids"].(*schema.Set).List())
+
+		result = append(result, msgraph.X509CertificateCombinationConfiguration{
+			CombinationConfiguration: msgraph.CombinationConfiguration{
+				AppliesToCombinations: &appliesTo,
+			},
+			AllowedIssuerSkis: &allowedIssuerSkis,
+			AllowedPolicyOIDs: &allowedPolicyOIDs,
+		})
 	}
+	return result
+}
 
-	log.Printf("[DEBUG] Waiting for named location %q to be updated", d.Id())
-	timeout, _ := ctx.Deadline()
-	stateConf := &resource.StateChangeConf{ //nolint:staticcheck
-		Pending:                   []string{"Pending"},
-		Target:                    []string{"Updated"},
-		Timeout:                   time.Until(timeout),
-		MinTimeout:                5 * time.Second,
-		ContinuousTargetOccurence: 5,
-		Refresh:                   updateRefreshFunc,
+func flattenX509CertificateCombinationConfigurations(input *[]msgraph.X509CertificateCombinationConfiguration) []interface{} {
+	result := make([]interface{}, 0)
+	if input == nil {
+		return result
 	}
-	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
-		return tf.ErrorDiagF(err, "waiting for update of named location with ID %q", d.Id())
+
+	for _, config := range *input {
+		result = append(result, map[string]interface{}{
+			"applies_to_combinations": flattenAuthenticationStrengthAllowedCombinations(config.AppliesToCombinations),
+			"allowed_issuer_skis":     flattenStringList(config.AllowedIssuerSkis),
+			"allowed_policy_oids":     flattenStringList(config.AllowedPolicyOIDs),
+		})
 	}
+	return result
+}
 
-	return authenticationStrengthResourceRead(ctx, d, meta)
+// combinationConfigurationKey returns the natural key for a combinationConfiguration, since Graph does not
+// allow more than one configuration to target the same set of allowedCombinations.
+func combinationConfigurationKey(combinations *[]string) string {
+	if combinations == nil {
+		return ""
+	}
+	sorted := append([]string(nil), *combinations...)
+	sort.Strings(sorted)
+	return strings.Join(sorted, ",")
 }
 
-func authenticationStrengthResourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*clients.Client).ConditionalAccess.authenticationStrengthClient
+// combinationConfigurationBatchThreshold is the number of combinationConfigurations to be created, updated
+// or deleted in one reconciliation above which the $batch endpoint is used instead of one request per
+// child, since $batch carries its own fixed overhead that isn't worth paying for a handful of items.
+const combinationConfigurationBatchThreshold = 3
 
-	result, status, err := client.Get(ctx, d.Id(), odata.Query{})
+// reconcileCombinationConfigurations diffs the desired set of combinationConfigurations against those
+// currently stored against the policy, keyed by appliesToCombinations, and creates, updates or deletes
+// child resources as needed to converge on the desired set.
+func reconcileCombinationConfigurations(ctx context.Context, client *msgraph.AuthenticationStrengthPoliciesClient, policyId string, desired []msgraph.X509CertificateCombinationConfiguration, batchOperationsEnabled bool) error {
+	existing, _, err := client.ListCombinationConfigurations(ctx, policyId, odata.Query{})
 	if err != nil {
-		if status == http.StatusNotFound {
-			log.Printf("[DEBUG] Named Location with Object ID %q was not found - removing from state", d.Id())
-			d.SetId("")
-			return nil
-		}
-	}
-	if result == nil {
-		return tf.ErrorDiagF(errors.New("Bad API response"), "Result is nil")
+		return fmt.Errorf("listing combination configurations: %v", err)
 	}
 
-	location := *result
-
-	if ipnl, ok := location.(msgraph.IPauthenticationStrength); ok {
-		if ipnl.ID == nil {
-			return tf.ErrorDiagF(errors.New("Bad API response"), "ID is nil for returned IP Named Location")
+	existingByKey := make(map[string]msgraph.X509CertificateCombinationConfiguration)
+	if existing != nil {
+		for _, config := range *existing {
+			existingByKey[combinationConfigurationKey(config.AppliesToCombinations)] = config
 		}
-		d.SetId(*ipnl.ID)
-		tf.Set(d, "display_name", ipnl.DisplayName)
-		tf.Set(d, "ip", flattenIPauthenticationStrength(&ipnl))
 	}
 
-	if cnl, ok := location.(msgraph.CountryauthenticationStrength); ok {
-		if cnl.ID == nil {
-			return tf.ErrorDiagF(errors.New("Bad API response"), "ID is nil for returned Country Named Location")
+	var toCreate, toUpdate []msgraph.X509CertificateCombinationConfiguration
+	desiredKeys := make(map[string]struct{}, len(desired))
+	for _, config := range desired {
+		key := combinationConfigurationKey(config.AppliesToCombinations)
+		desiredKeys[key] = struct{}{}
+
+		if existingConfig, ok := existingByKey[key]; ok {
+			config.ID = existingConfig.ID
+			toUpdate = append(toUpdate, config)
+		} else {
+			toCreate = append(toCreate, config)
 		}
-		d.SetId(*cnl.ID)
-		tf.Set(d, "display_name", cnl.DisplayName)
-		tf.Set(d, "country", flattenCountryauthenticationStrength(&cnl))
 	}
 
-	return nil
-}
-
-func authenticationStrengthResourceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*clients.Client).ConditionalAccess.authenticationStrengthsClient
-	authenticationStrengthId := d.Id()
+	var toDeleteIds []string
+	for key, config := range existingByKey {
+		if _, ok := desiredKeys[key]; ok || config.ID == nil {
+			continue
+		}
+		toDeleteIds = append(toDeleteIds, *config.ID)
+	}
 
-	if _, ok := d.GetOk("ip"); ok {
-		resp, status, err := client.GetIP(ctx, authenticationStrengthId, odata.Query{})
+	if batchOperationsEnabled && len(toCreate)+len(toUpdate)+len(toDeleteIds) > combinationConfigurationBatchThreshold {
+		errs, err := client.BatchReconcileCombinationConfigurations(ctx, policyId, toCreate, toUpdate, toDeleteIds)
 		if err != nil {
-			if status == http.StatusNotFound {
-				log.Printf("[DEBUG] Named Location with ID %q already deleted", authenticationStrengthId)
-				return nil
-			}
-
-			return tf.ErrorDiagPathF(err, "id", "Retrieving named location with ID %q", authenticationStrengthId)
+			return fmt.Errorf("batch reconciling combination configurations: %v", err)
 		}
-		if resp != nil && resp.IsTrusted != nil && *resp.IsTrusted {
-			properties := msgraph.IPauthenticationStrength{
-				BaseauthenticationStrength: &msgraph.BaseauthenticationStrength{
-					ID: &authenticationStrengthId,
-				},
-				IsTrusted: utils.Bool(false),
+		if len(errs) > 0 {
+			ids := make([]string, 0, len(errs))
+			for id := range errs {
+				ids = append(ids, id)
 			}
-			if _, err := client.UpdateIP(ctx, properties); err != nil {
-				return tf.ErrorDiagF(err, "Updating named location with ID %q", authenticationStrengthId)
+			sort.Strings(ids)
+
+			messages := make([]string, 0, len(errs))
+			for _, id := range ids {
+				messages = append(messages, fmt.Sprintf("%s: %v", id, errs[id]))
 			}
+			return fmt.Errorf("reconciling combination configurations: %s", strings.Join(messages, "; "))
 		}
+		return nil
 	}
 
-	if _, ok := d.GetOk("country"); ok {
-		if _, status, err := client.GetCountry(ctx, authenticationStrengthId, odata.Query{}); err != nil {
-			if status == http.StatusNotFound {
-				log.Printf("[DEBUG] Named Location with ID %q already deleted", authenticationStrengthId)
-				return nil
-			}
-
-			return tf.ErrorDiagPathF(err, "id", "Retrieving named location with ID %q", authenticationStrengthId)
+	for _, config := range toUpdate {
+		key := combinationConfigurationKey(config.AppliesToCombinations)
+		if _, err := client.UpdateCombinationConfiguration(ctx, policyId, config); err != nil {
+			return fmt.Errorf("updating combination configuration %q: %v", key, err)
 		}
 	}
 
-	status, err := client.Delete(ctx, authenticationStrengthId)
-	if err != nil {
-		return tf.ErrorDiagPathF(err, "id", "Deleting named location with ID %q, got status %d", authenticationStrengthId, status)
+	// Deletes must run before creates: an allowedCombinations mode can only live in one
+	// combinationConfiguration at a time, so creating a new configuration whose appliesToCombinations
+	// overlaps a still-present configuration that's about to be deleted would 400.
+	for _, id := range toDeleteIds {
+		if _, err := client.DeleteCombinationConfiguration(ctx, policyId, id); err != nil {
+			return fmt.Errorf("deleting combination configuration %q: %v", id, err)
+		}
 	}
 
-	if err := helpers.WaitForDeletion(ctx, func(ctx context.Context) (*bool, error) {
-		defer func() { client.BaseClient.DisableRetries = false }()
-		client.BaseClient.DisableRetries = true
-		if _, status, err := client.Get(ctx, authenticationStrengthId, odata.Query{}); err != nil {
-			if status == http.StatusNotFound {
-				return utils.Bool(false), nil
-			}
-			return nil, err
+	for _, config := range toCreate {
+		key := combinationConfigurationKey(config.AppliesToCombinations)
+		if _, _, err := client.CreateCombinationConfiguration(ctx, policyId, config); err != nil {
+			return fmt.Errorf("creating combination configuration %q: %v", key, err)
 		}
-		return utils.Bool(true), nil
-	}); err != nil {
-		return tf.ErrorDiagF(err, "waiting for deletion of named location with ID %q", authenticationStrengthId)
 	}
 
 	return nil
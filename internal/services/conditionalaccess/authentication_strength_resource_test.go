@@ -0,0 +1,127 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conditionalaccess_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+)
+
+type AuthenticationStrengthPolicyResource struct{}
+
+func TestAccAuthenticationStrengthPolicy_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_authentication_strength_policy", "test")
+	r := AuthenticationStrengthPolicyResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("allowed_combinations.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccAuthenticationStrengthPolicy_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_authentication_strength_policy", "test")
+	r := AuthenticationStrengthPolicyResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.complete(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("x509_certificate_combination_configuration.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccAuthenticationStrengthPolicy_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_authentication_strength_policy", "test")
+	r := AuthenticationStrengthPolicyResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (AuthenticationStrengthPolicyResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	client := clients.ConditionalAccess.AuthenticationStrengthPoliciesClient
+
+	policy, status, err := client.Get(ctx, state.ID, odata.Query{})
+	if err != nil {
+		if status == http.StatusNotFound {
+			return pointerTo(false), nil
+		}
+		return nil, fmt.Errorf("failed to retrieve authentication strength policy with ID %q: %v", state.ID, err)
+	}
+
+	return pointerTo(policy != nil), nil
+}
+
+func pointerTo(b bool) *bool {
+	return &b
+}
+
+func (AuthenticationStrengthPolicyResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+resource "azuread_authentication_strength_policy" "test" {
+  display_name         = "acctest-AuthStrength-%d"
+  allowed_combinations  = ["fido2"]
+}
+`, data.RandomInteger)
+}
+
+func (AuthenticationStrengthPolicyResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+resource "azuread_authentication_strength_policy" "test" {
+  display_name         = "acctest-AuthStrength-%d"
+  description          = "Created by acceptance test"
+  allowed_combinations  = ["x509CertificateMultiFactor"]
+
+  x509_certificate_combination_configuration {
+    applies_to_combinations = ["x509CertificateMultiFactor"]
+    allowed_policy_oids     = ["1.2.3.4.5"]
+  }
+}
+`, data.RandomInteger)
+}
@@ -0,0 +1,84 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conditionalaccess
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/manicminer/hamilton/msgraph"
+)
+
+// authenticationStrengthUsageElem describes one entry in `used_by_conditional_access_policies`.
+func authenticationStrengthUsageElem() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"conditional_access_policy_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"display_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// listAuthenticationStrengthPolicyUsage returns the conditional access policies that reference the given
+// authentication strength policy in their grant controls.
+//
+// Graph does not currently expose a dedicated usage endpoint for authenticationStrengthPolicies, so this
+// lists every conditional access policy and filters client-side on grantControls.authenticationStrength.id.
+func listAuthenticationStrengthPolicyUsage(ctx context.Context, client *msgraph.ConditionalAccessPoliciesClient, authenticationStrengthPolicyId string) ([]interface{}, error) {
+	policies, _, err := client.List(ctx, odata.Query{})
+	if err != nil {
+		return nil, fmt.Errorf("listing conditional access policies: %v", err)
+	}
+
+	usage := make([]interface{}, 0)
+	if policies == nil {
+		return usage, nil
+	}
+
+	for _, policy := range *policies {
+		if policy.GrantControls == nil || policy.GrantControls.AuthenticationStrength == nil {
+			continue
+		}
+		if policy.GrantControls.AuthenticationStrength.ID == nil || *policy.GrantControls.AuthenticationStrength.ID != authenticationStrengthPolicyId {
+			continue
+		}
+
+		id := ""
+		if policy.ID != nil {
+			id = *policy.ID
+		}
+
+		displayName := ""
+		if policy.DisplayName != nil {
+			displayName = *policy.DisplayName
+		}
+
+		state := ""
+		if policy.State != nil {
+			state = string(*policy.State)
+		}
+
+		usage = append(usage, map[string]interface{}{
+			"conditional_access_policy_id": id,
+			"display_name":                 displayName,
+			"state":                        state,
+		})
+	}
+
+	return usage, nil
+}
@@ -9,14 +9,16 @@ import (
 )
 
 type Client struct {
-	AuthenticationStrengthClient *msgraph.AuthenticationStrengthClient
-	NamedLocationsClient         *msgraph.NamedLocationsClient
-	PoliciesClient               *msgraph.ConditionalAccessPoliciesClient
+	AuthenticationStrengthPoliciesClient *msgraph.AuthenticationStrengthPoliciesClient
+	NamedLocationsClient                 *msgraph.NamedLocationsClient
+	PoliciesClient                       *msgraph.ConditionalAccessPoliciesClient
+
+	BatchOperationsEnabled bool
 }
 
 func NewClient(o *common.ClientOptions) *Client {
-	authenticationStrengthClient := msgraph.NewAuthenticationStrengthClient()
-	o.ConfigureClient(&authenticationStrengthClient.BaseClient)
+	authenticationStrengthPoliciesClient := msgraph.NewAuthenticationStrengthPoliciesClient()
+	o.ConfigureClient(&authenticationStrengthPoliciesClient.BaseClient)
 
 	namedLocationsClient := msgraph.NewNamedLocationsClient()
 	o.ConfigureClient(&namedLocationsClient.BaseClient)
@@ -25,8 +27,10 @@ func NewClient(o *common.ClientOptions) *Client {
 	o.ConfigureClient(&policiesClient.BaseClient)
 
 	return &Client{
-		AuthenticationStrengthClient: authenticationStrengthClient,
-		NamedLocationsClient:         namedLocationsClient,
-		PoliciesClient:               policiesClient,
+		AuthenticationStrengthPoliciesClient: authenticationStrengthPoliciesClient,
+		NamedLocationsClient:                 namedLocationsClient,
+		PoliciesClient:                       policiesClient,
+
+		BatchOperationsEnabled: o.BatchOperationsEnabled,
 	}
 }
@@ -0,0 +1,687 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conditionalaccess
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/utils"
+	"github.com/hashicorp/terraform-provider-azuread/internal/validate"
+	"github.com/manicminer/hamilton/msgraph"
+)
+
+var conditionalAccessPolicyStates = []string{
+	string(msgraph.ConditionalAccessPolicyStateEnabled),
+	string(msgraph.ConditionalAccessPolicyStateDisabled),
+	string(msgraph.ConditionalAccessPolicyStateEnabledForReportingButNotEnforced),
+}
+
+var conditionalAccessGrantControlOperators = []string{"AND", "OR"}
+
+func conditionalAccessPolicyResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: conditionalAccessPolicyResourceCreate,
+		ReadContext:   conditionalAccessPolicyResourceRead,
+		UpdateContext: conditionalAccessPolicyResourceUpdate,
+		DeleteContext: conditionalAccessPolicyResourceDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: tf.ValidateResourceIDPriorToImport(func(id string) error {
+			if _, err := uuid.ParseUUID(id); err != nil {
+				return fmt.Errorf("specified ID (%q) is not valid: %s", id, err)
+			}
+			return nil
+		}),
+
+		CustomizeDiff: conditionalAccessPolicyResourceCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"display_name": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validate.NoEmptyStrings,
+			},
+
+			"state": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(conditionalAccessPolicyStates, false),
+			},
+
+			"conditions": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"client_app_types": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+
+						"sign_in_risk_levels": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+
+						"user_risk_levels": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+
+						"applications": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"included_applications": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+									},
+									"excluded_applications": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+									},
+									"included_user_actions": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+									},
+								},
+							},
+						},
+
+						"users": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"included_users": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+									},
+									"excluded_users": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+									},
+									"included_groups": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+									},
+									"excluded_groups": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+									},
+									"included_roles": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+									},
+									"excluded_roles": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+									},
+								},
+							},
+						},
+
+						"platforms": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"included_platforms": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+									},
+									"excluded_platforms": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+									},
+								},
+							},
+						},
+
+						"locations": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"included_locations": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+									},
+									"excluded_locations": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"session_controls": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"disable_resilience_defaults": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+					},
+				},
+			},
+
+			"grant_controls": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"operator": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(conditionalAccessGrantControlOperators, false),
+						},
+
+						"built_in_controls": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+
+						"custom_authentication_factors": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+
+						"terms_of_use": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+
+						// Graph currently rejects a grant control that combines `mfa` in `built_in_controls`
+						// with an `authentication_strength_policy_id`, so `built_in_controls` may be left
+						// empty when an authentication strength is specified.
+						"authentication_strength_policy_id": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateDiagFunc: validate.UUID,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func conditionalAccessPolicyResourceCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	grantControlsRaw, ok := diff.GetOk("grant_controls")
+	if !ok {
+		return nil
+	}
+
+	grantControlsList := grantControlsRaw.([]interface{})
+	if len(grantControlsList) == 0 || grantControlsList[0] == nil {
+		return nil
+	}
+
+	grantControls := grantControlsList[0].(map[string]interface{})
+
+	authStrengthId, _ := grantControls["authentication_strength_policy_id"].(string)
+	builtInControls := grantControls["built_in_controls"].([]interface{})
+
+	if authStrengthId != "" {
+		for _, v := range builtInControls {
+			if v.(string) == "mfa" {
+				return errors.New("`grant_controls.0.built_in_controls` cannot contain `mfa` when `grant_controls.0.authentication_strength_policy_id` is specified")
+			}
+		}
+	}
+
+	return nil
+}
+
+func conditionalAccessPolicyResourceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*clients.Client).ConditionalAccess.PoliciesClient
+
+	properties := expandConditionalAccessPolicy(d)
+
+	policy, _, err := client.Create(ctx, *properties)
+	if err != nil {
+		return tf.ErrorDiagF(err, "Could not create conditional access policy")
+	}
+	if policy.ID == nil || *policy.ID == "" {
+		return tf.ErrorDiagF(errors.New("Bad API response"), "Object ID returned for conditional access policy is nil/empty")
+	}
+
+	d.SetId(*policy.ID)
+
+	return conditionalAccessPolicyResourceRead(ctx, d, meta)
+}
+
+func conditionalAccessPolicyResourceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*clients.Client).ConditionalAccess.PoliciesClient
+	id := d.Id()
+
+	properties := expandConditionalAccessPolicy(d)
+	properties.ID = &id
+
+	if _, err := client.Update(ctx, *properties); err != nil {
+		return tf.ErrorDiagF(err, "Could not update conditional access policy with ID %q", id)
+	}
+
+	return conditionalAccessPolicyResourceRead(ctx, d, meta)
+}
+
+func conditionalAccessPolicyResourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*clients.Client).ConditionalAccess.PoliciesClient
+
+	policy, status, err := client.Get(ctx, d.Id(), odata.Query{})
+	if err != nil {
+		if status == http.StatusNotFound {
+			log.Printf("[DEBUG] Conditional Access Policy with Object ID %q was not found - removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return tf.ErrorDiagF(err, "Retrieving conditional access policy with ID %q", d.Id())
+	}
+	if policy == nil {
+		return tf.ErrorDiagF(errors.New("Bad API response"), "Result is nil")
+	}
+
+	tf.Set(d, "display_name", policy.DisplayName)
+	if policy.State != nil {
+		tf.Set(d, "state", string(*policy.State))
+	}
+	tf.Set(d, "conditions", flattenConditionalAccessConditionSet(policy.Conditions))
+	tf.Set(d, "grant_controls", flattenConditionalAccessGrantControls(policy.GrantControls))
+	tf.Set(d, "session_controls", flattenConditionalAccessSessionControls(policy.SessionControls))
+
+	return nil
+}
+
+func conditionalAccessPolicyResourceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*clients.Client).ConditionalAccess.PoliciesClient
+	id := d.Id()
+
+	if status, err := client.Delete(ctx, id); err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Deleting conditional access policy with ID %q, got status %d", id, status)
+	}
+
+	return nil
+}
+
+func expandConditionalAccessPolicy(d *schema.ResourceData) *msgraph.ConditionalAccessPolicy {
+	displayName := d.Get("display_name").(string)
+	state := msgraph.ConditionalAccessPolicyState(d.Get("state").(string))
+
+	policy := msgraph.ConditionalAccessPolicy{
+		DisplayName: &displayName,
+		State:       &state,
+		Conditions:  expandConditionalAccessConditionSet(d.Get("conditions").([]interface{})),
+	}
+
+	if v, ok := d.GetOk("grant_controls"); ok {
+		policy.GrantControls = expandConditionalAccessGrantControls(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("session_controls"); ok {
+		policy.SessionControls = expandConditionalAccessSessionControls(v.([]interface{}))
+	}
+
+	return &policy
+}
+
+func expandConditionalAccessConditionSet(input []interface{}) *msgraph.ConditionalAccessConditionSet {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+	raw := input[0].(map[string]interface{})
+
+	clientAppTypes := expandStringList(raw["client_app_types"].([]interface{}))
+	signInRiskLevels := expandStringList(raw["sign_in_risk_levels"].([]interface{}))
+	userRiskLevels := expandStringList(raw["user_risk_levels"].([]interface{}))
+
+	conditions := msgraph.ConditionalAccessConditionSet{
+		ClientAppTypes:   &clientAppTypes,
+		SignInRiskLevels: &signInRiskLevels,
+		UserRiskLevels:   &userRiskLevels,
+		Applications:     expandConditionalAccessApplications(raw["applications"].([]interface{})),
+		Users:            expandConditionalAccessUsers(raw["users"].([]interface{})),
+		Platforms:        expandConditionalAccessPlatforms(raw["platforms"].([]interface{})),
+		Locations:        expandConditionalAccessLocations(raw["locations"].([]interface{})),
+	}
+
+	return &conditions
+}
+
+func expandConditionalAccessApplications(input []interface{}) *msgraph.ConditionalAccessApplications {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+	raw := input[0].(map[string]interface{})
+
+	included := expandStringList(raw["included_applications"].([]interface{}))
+	excluded := expandStringList(raw["excluded_applications"].([]interface{}))
+	userActions := expandStringList(raw["included_user_actions"].([]interface{}))
+
+	return &msgraph.ConditionalAccessApplications{
+		IncludeApplications: &included,
+		ExcludeApplications: &excluded,
+		IncludeUserActions:  &userActions,
+	}
+}
+
+func expandConditionalAccessUsers(input []interface{}) *msgraph.ConditionalAccessUsers {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+	raw := input[0].(map[string]interface{})
+
+	includedUsers := expandStringList(raw["included_users"].([]interface{}))
+	excludedUsers := expandStringList(raw["excluded_users"].([]interface{}))
+	includedGroups := expandStringList(raw["included_groups"].([]interface{}))
+	excludedGroups := expandStringList(raw["excluded_groups"].([]interface{}))
+	includedRoles := expandStringList(raw["included_roles"].([]interface{}))
+	excludedRoles := expandStringList(raw["excluded_roles"].([]interface{}))
+
+	return &msgraph.ConditionalAccessUsers{
+		IncludeUsers:  &includedUsers,
+		ExcludeUsers:  &excludedUsers,
+		IncludeGroups: &includedGroups,
+		ExcludeGroups: &excludedGroups,
+		IncludeRoles:  &includedRoles,
+		ExcludeRoles:  &excludedRoles,
+	}
+}
+
+func expandConditionalAccessPlatforms(input []interface{}) *msgraph.ConditionalAccessPlatforms {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+	raw := input[0].(map[string]interface{})
+
+	included := expandStringList(raw["included_platforms"].([]interface{}))
+	excluded := expandStringList(raw["excluded_platforms"].([]interface{}))
+
+	return &msgraph.ConditionalAccessPlatforms{
+		IncludePlatforms: &included,
+		ExcludePlatforms: &excluded,
+	}
+}
+
+func expandConditionalAccessLocations(input []interface{}) *msgraph.ConditionalAccessLocations {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+	raw := input[0].(map[string]interface{})
+
+	included := expandStringList(raw["included_locations"].([]interface{}))
+	excluded := expandStringList(raw["excluded_locations"].([]interface{}))
+
+	return &msgraph.ConditionalAccessLocations{
+		IncludeLocations: &included,
+		ExcludeLocations: &excluded,
+	}
+}
+
+func expandConditionalAccessSessionControls(input []interface{}) *msgraph.ConditionalAccessSessionControls {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+	raw := input[0].(map[string]interface{})
+
+	disableResilienceDefaults := raw["disable_resilience_defaults"].(bool)
+
+	return &msgraph.ConditionalAccessSessionControls{
+		DisableResilienceDefaults: &disableResilienceDefaults,
+	}
+}
+
+func expandConditionalAccessGrantControls(input []interface{}) *msgraph.ConditionalAccessGrantControls {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+	raw := input[0].(map[string]interface{})
+
+	operator := raw["operator"].(string)
+	builtInControls := expandStringList(raw["built_in_controls"].([]interface{}))
+	customFactors := expandStringList(raw["custom_authentication_factors"].([]interface{}))
+	termsOfUse := expandStringList(raw["terms_of_use"].([]interface{}))
+
+	grantControls := msgraph.ConditionalAccessGrantControls{
+		Operator:                    &operator,
+		BuiltInControls:             &builtInControls,
+		CustomAuthenticationFactors: &customFactors,
+		TermsOfUse:                  &termsOfUse,
+	}
+
+	if authStrengthId, ok := raw["authentication_strength_policy_id"].(string); ok && authStrengthId != "" {
+		grantControls.AuthenticationStrength = &msgraph.AuthenticationStrengthPolicy{
+			ID: utils.String(authStrengthId),
+		}
+	}
+
+	return &grantControls
+}
+
+func flattenConditionalAccessConditionSet(input *msgraph.ConditionalAccessConditionSet) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"client_app_types":    flattenStringList(input.ClientAppTypes),
+			"sign_in_risk_levels": flattenStringList(input.SignInRiskLevels),
+			"user_risk_levels":    flattenStringList(input.UserRiskLevels),
+			"applications":        flattenConditionalAccessApplications(input.Applications),
+			"users":               flattenConditionalAccessUsers(input.Users),
+			"platforms":           flattenConditionalAccessPlatforms(input.Platforms),
+			"locations":           flattenConditionalAccessLocations(input.Locations),
+		},
+	}
+}
+
+func flattenConditionalAccessApplications(input *msgraph.ConditionalAccessApplications) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"included_applications": flattenStringList(input.IncludeApplications),
+			"excluded_applications": flattenStringList(input.ExcludeApplications),
+			"included_user_actions": flattenStringList(input.IncludeUserActions),
+		},
+	}
+}
+
+func flattenConditionalAccessUsers(input *msgraph.ConditionalAccessUsers) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"included_users":  flattenStringList(input.IncludeUsers),
+			"excluded_users":  flattenStringList(input.ExcludeUsers),
+			"included_groups": flattenStringList(input.IncludeGroups),
+			"excluded_groups": flattenStringList(input.ExcludeGroups),
+			"included_roles":  flattenStringList(input.IncludeRoles),
+			"excluded_roles":  flattenStringList(input.ExcludeRoles),
+		},
+	}
+}
+
+func flattenConditionalAccessPlatforms(input *msgraph.ConditionalAccessPlatforms) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"included_platforms": flattenStringList(input.IncludePlatforms),
+			"excluded_platforms": flattenStringList(input.ExcludePlatforms),
+		},
+	}
+}
+
+func flattenConditionalAccessLocations(input *msgraph.ConditionalAccessLocations) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"included_locations": flattenStringList(input.IncludeLocations),
+			"excluded_locations": flattenStringList(input.ExcludeLocations),
+		},
+	}
+}
+
+func flattenConditionalAccessSessionControls(input *msgraph.ConditionalAccessSessionControls) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	disableResilienceDefaults := false
+	if input.DisableResilienceDefaults != nil {
+		disableResilienceDefaults = *input.DisableResilienceDefaults
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"disable_resilience_defaults": disableResilienceDefaults,
+		},
+	}
+}
+
+func flattenConditionalAccessGrantControls(input *msgraph.ConditionalAccessGrantControls) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	operator := ""
+	if input.Operator != nil {
+		operator = *input.Operator
+	}
+
+	authStrengthId := ""
+	if input.AuthenticationStrength != nil && input.AuthenticationStrength.ID != nil {
+		authStrengthId = *input.AuthenticationStrength.ID
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"operator":                          operator,
+			"built_in_controls":                 flattenStringList(input.BuiltInControls),
+			"custom_authentication_factors":     flattenStringList(input.CustomAuthenticationFactors),
+			"terms_of_use":                      flattenStringList(input.TermsOfUse),
+			"authentication_strength_policy_id": authStrengthId,
+		},
+	}
+}
+
+// expandStringList converts a schema.TypeList of strings into a Graph-ready []string. Unlike
+// expandAuthenticationStrengthAllowedCombinations, this carries no authentication strength semantics; it's
+// a plain type conversion shared by every unrelated []interface{}-of-strings field on this resource
+// (client app types, users, groups, roles, platforms, locations, grant controls, and so on).
+func expandStringList(input []interface{}) []string {
+	result := make([]string, 0, len(input))
+	for _, v := range input {
+		result = append(result, v.(string))
+	}
+	return result
+}
+
+// flattenStringList is the Read-side counterpart to expandStringList.
+func flattenStringList(input *[]string) []string {
+	if input == nil {
+		return []string{}
+	}
+	return *input
+}
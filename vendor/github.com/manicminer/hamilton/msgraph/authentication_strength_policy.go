@@ -141,6 +141,47 @@ func (c *AuthenticationStrengthPoliciesClient) Update(ctx context.Context, Authe
 	return status, nil
 }
 
+// UpdateAllowedCombinations amends the allowedCombinations of an existing AuthenticationStrengthPolicy
+// using the dedicated updateAllowedCombinations action, rather than a general PATCH, as Graph rejects a
+// PATCH that carries allowedCombinations.
+func (c *AuthenticationStrengthPoliciesClient) UpdateAllowedCombinations(ctx context.Context, id string, allowedCombinations []string) (*AuthenticationStrengthPolicy, int, error) {
+	var status int
+
+	body, err := json.Marshal(struct {
+		AllowedCombinations []string `json:"allowedCombinations"`
+	}{
+		AllowedCombinations: allowedCombinations,
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("json.Marshal(): %v", err)
+	}
+
+	resp, status, _, err := c.BaseClient.Post(ctx, PostHttpRequestInput{
+		Body:                   body,
+		ConsistencyFailureFunc: RetryOn404ConsistencyFailureFunc,
+		ValidStatusCodes:       []int{http.StatusOK},
+		Uri: Uri{
+			Entity: fmt.Sprintf("/policies/authenticationStrengthPolicies/%s/updateAllowedCombinations", id),
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("AuthenticationStrengthPoliciesClient.BaseClient.Post(): %v", err)
+	}
+
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("io.ReadAll(): %v", err)
+	}
+
+	var updatedAuthenticationStrengthPolicy AuthenticationStrengthPolicy
+	if err := json.Unmarshal(respBody, &updatedAuthenticationStrengthPolicy); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+
+	return &updatedAuthenticationStrengthPolicy, status, nil
+}
+
 // Delete removes a AuthenticationStrengthPolicy.
 func (c *AuthenticationStrengthPoliciesClient) Delete(ctx context.Context, id string) (int, error) {
 	_, status, _, err := c.BaseClient.Delete(ctx, DeleteHttpRequestInput{
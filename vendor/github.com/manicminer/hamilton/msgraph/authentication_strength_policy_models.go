@@ -0,0 +1,13 @@
+package msgraph
+
+// AuthenticationStrengthPolicy describes an authenticationStrengthPolicy object.
+type AuthenticationStrengthPolicy struct {
+	ID                    *string   `json:"id,omitempty"`
+	DisplayName           *string   `json:"displayName,omitempty"`
+	Description           *string   `json:"description,omitempty"`
+	PolicyType            *string   `json:"policyType,omitempty"`
+	RequirementsSatisfied *string   `json:"requirementsSatisfied,omitempty"`
+	AllowedCombinations   *[]string `json:"allowedCombinations,omitempty"`
+	CreatedDateTime       *string   `json:"createdDateTime,omitempty"`
+	ModifiedDateTime      *string   `json:"modifiedDateTime,omitempty"`
+}
@@ -0,0 +1,79 @@
+package msgraph
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// batchMaxRequestsPerCall is the number of sub-requests Graph allows per call to $batch.
+const batchMaxRequestsPerCall = 20
+
+type batchRequestItem struct {
+	ID        string            `json:"id"`
+	Method    string            `json:"method"`
+	URL       string            `json:"url"`
+	Headers   map[string]string `json:"headers,omitempty"`
+	Body      json.RawMessage   `json:"body,omitempty"`
+	DependsOn []string          `json:"dependsOn,omitempty"`
+}
+
+type batchRequest struct {
+	Requests []batchRequestItem `json:"requests"`
+}
+
+type batchResponseItem struct {
+	ID     string          `json:"id"`
+	Status int             `json:"status"`
+	Body   json.RawMessage `json:"body,omitempty"`
+}
+
+type batchResponse struct {
+	Responses []batchResponseItem `json:"responses"`
+}
+
+// batch posts up to batchMaxRequestsPerCall sub-requests per call to Graph's $batch endpoint, correlating
+// responses by id, and returns them keyed by id regardless of whether each individual sub-request succeeded.
+// An error is only returned for a transport-level failure of the $batch call itself; per-item failures are
+// reported in the returned batchResponseItem.Status/Body for the caller to inspect.
+func (c *AuthenticationStrengthPoliciesClient) batch(ctx context.Context, items []batchRequestItem) (map[string]batchResponseItem, error) {
+	results := make(map[string]batchResponseItem, len(items))
+
+	for start := 0; start < len(items); start += batchMaxRequestsPerCall {
+		end := start + batchMaxRequestsPerCall
+		if end > len(items) {
+			end = len(items)
+		}
+		chunk := items[start:end]
+
+		body, err := json.Marshal(batchRequest{Requests: chunk})
+		if err != nil {
+			return nil, fmt.Errorf("json.Marshal(): %v", err)
+		}
+
+		resp, _, _, err := c.BaseClient.Post(ctx, PostHttpRequestInput{
+			Body:             body,
+			ValidStatusCodes: []int{http.StatusOK},
+			Uri: Uri{
+				Entity: "/$batch",
+			},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("AuthenticationStrengthPoliciesClient.BaseClient.Post(): %v", err)
+		}
+
+		var decoded batchResponse
+		if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+			resp.Body.Close()
+			return nil, fmt.Errorf("json.Decode(): %v", err)
+		}
+		resp.Body.Close()
+
+		for _, item := range decoded.Responses {
+			results[item.ID] = item
+		}
+	}
+
+	return results, nil
+}
@@ -0,0 +1,20 @@
+package msgraph
+
+// CombinationConfiguration describes the common fields of a combinationConfiguration child resource of an
+// AuthenticationStrengthPolicy. Graph models this as an abstract type; X509CertificateCombinationConfiguration
+// is currently the only concrete type exposed by the API.
+type CombinationConfiguration struct {
+	ODataType             *string   `json:"@odata.type,omitempty"`
+	ID                    *string   `json:"id,omitempty"`
+	AppliesToCombinations *[]string `json:"appliesToCombinations,omitempty"`
+}
+
+// X509CertificateCombinationConfiguration restricts the x509CertificateSingleFactor and/or
+// x509CertificateMultiFactor combinations to certificates issued by a specific set of CAs (identified by
+// Subject Key Identifier) and/or carrying a specific set of certificate policy OIDs.
+type X509CertificateCombinationConfiguration struct {
+	CombinationConfiguration
+
+	AllowedIssuerSkis *[]string `json:"allowedIssuerSkis,omitempty"`
+	AllowedPolicyOIDs *[]string `json:"allowedPolicyOIDs,omitempty"`
+}
@@ -0,0 +1,158 @@
+package msgraph
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+)
+
+// ConditionalAccessPoliciesClient performs operations on ConditionalAccessPolicy.
+type ConditionalAccessPoliciesClient struct {
+	BaseClient Client
+}
+
+// NewConditionalAccessPoliciesClient returns a new ConditionalAccessPoliciesClient
+func NewConditionalAccessPoliciesClient() *ConditionalAccessPoliciesClient {
+	return &ConditionalAccessPoliciesClient{
+		BaseClient: NewClient(VersionV1),
+	}
+}
+
+// List returns a list of ConditionalAccessPolicy, optionally queried using OData.
+func (c *ConditionalAccessPoliciesClient) List(ctx context.Context, query odata.Query) (*[]ConditionalAccessPolicy, int, error) {
+	resp, status, _, err := c.BaseClient.Get(ctx, GetHttpRequestInput{
+		OData:            query,
+		ValidStatusCodes: []int{http.StatusOK},
+		Uri: Uri{
+			Entity: "/identity/conditionalAccess/policies",
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("ConditionalAccessPoliciesClient.BaseClient.Get(): %v", err)
+	}
+
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("io.ReadAll(): %v", err)
+	}
+
+	var data struct {
+		ConditionalAccessPolicies []ConditionalAccessPolicy `json:"value"`
+	}
+	if err := json.Unmarshal(respBody, &data); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+
+	return &data.ConditionalAccessPolicies, status, nil
+}
+
+// Create creates a new ConditionalAccessPolicy.
+func (c *ConditionalAccessPoliciesClient) Create(ctx context.Context, policy ConditionalAccessPolicy) (*ConditionalAccessPolicy, int, error) {
+	var status int
+	body, err := json.Marshal(policy)
+	if err != nil {
+		return nil, status, fmt.Errorf("json.Marshal(): %v", err)
+	}
+
+	resp, status, _, err := c.BaseClient.Post(ctx, PostHttpRequestInput{
+		Body:             body,
+		ValidStatusCodes: []int{http.StatusCreated},
+		Uri: Uri{
+			Entity: "/identity/conditionalAccess/policies",
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("ConditionalAccessPoliciesClient.BaseClient.Post(): %v", err)
+	}
+
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("io.ReadAll(): %v", err)
+	}
+
+	var newPolicy ConditionalAccessPolicy
+	if err := json.Unmarshal(respBody, &newPolicy); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+
+	return &newPolicy, status, nil
+}
+
+// Get retrieves a ConditionalAccessPolicy.
+func (c *ConditionalAccessPoliciesClient) Get(ctx context.Context, id string, query odata.Query) (*ConditionalAccessPolicy, int, error) {
+	resp, status, _, err := c.BaseClient.Get(ctx, GetHttpRequestInput{
+		ConsistencyFailureFunc: RetryOn404ConsistencyFailureFunc,
+		OData:                  query,
+		ValidStatusCodes:       []int{http.StatusOK},
+		Uri: Uri{
+			Entity: fmt.Sprintf("/identity/conditionalAccess/policies/%s", id),
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("ConditionalAccessPoliciesClient.BaseClient.Get(): %v", err)
+	}
+
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("io.ReadAll(): %v", err)
+	}
+
+	var policy ConditionalAccessPolicy
+	if err := json.Unmarshal(respBody, &policy); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+
+	return &policy, status, nil
+}
+
+// Update amends an existing ConditionalAccessPolicy.
+func (c *ConditionalAccessPoliciesClient) Update(ctx context.Context, policy ConditionalAccessPolicy) (int, error) {
+	var status int
+
+	if policy.ID == nil {
+		return status, errors.New("cannot update ConditionalAccessPolicy with nil ID")
+	}
+
+	body, err := json.Marshal(policy)
+	if err != nil {
+		return status, fmt.Errorf("json.Marshal(): %v", err)
+	}
+
+	_, status, _, err = c.BaseClient.Patch(ctx, PatchHttpRequestInput{
+		Body:                   body,
+		ConsistencyFailureFunc: RetryOn404ConsistencyFailureFunc,
+		ValidStatusCodes:       []int{http.StatusNoContent},
+		Uri: Uri{
+			Entity: fmt.Sprintf("/identity/conditionalAccess/policies/%s", *policy.ID),
+		},
+	})
+	if err != nil {
+		return status, fmt.Errorf("ConditionalAccessPoliciesClient.BaseClient.Patch(): %v", err)
+	}
+
+	return status, nil
+}
+
+// Delete removes a ConditionalAccessPolicy.
+func (c *ConditionalAccessPoliciesClient) Delete(ctx context.Context, id string) (int, error) {
+	_, status, _, err := c.BaseClient.Delete(ctx, DeleteHttpRequestInput{
+		ConsistencyFailureFunc: RetryOn404ConsistencyFailureFunc,
+		ValidStatusCodes:       []int{http.StatusNoContent},
+		Uri: Uri{
+			Entity: fmt.Sprintf("/identity/conditionalAccess/policies/%s", id),
+		},
+	})
+	if err != nil {
+		return status, fmt.Errorf("ConditionalAccessPoliciesClient.BaseClient.Delete(): %v", err)
+	}
+
+	return status, nil
+}
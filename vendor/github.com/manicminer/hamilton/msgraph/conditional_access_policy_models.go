@@ -0,0 +1,75 @@
+package msgraph
+
+// ConditionalAccessPolicy describes a Conditional Access Policy.
+type ConditionalAccessPolicy struct {
+	ID              *string                           `json:"id,omitempty"`
+	DisplayName     *string                           `json:"displayName,omitempty"`
+	State           *ConditionalAccessPolicyState     `json:"state,omitempty"`
+	Conditions      *ConditionalAccessConditionSet    `json:"conditions,omitempty"`
+	GrantControls   *ConditionalAccessGrantControls   `json:"grantControls,omitempty"`
+	SessionControls *ConditionalAccessSessionControls `json:"sessionControls,omitempty"`
+}
+
+// ConditionalAccessPolicyState describes the state of a ConditionalAccessPolicy (enabled, disabled, or
+// enabledForReportingButNotEnforced).
+type ConditionalAccessPolicyState string
+
+const (
+	ConditionalAccessPolicyStateEnabled                           ConditionalAccessPolicyState = "enabled"
+	ConditionalAccessPolicyStateDisabled                          ConditionalAccessPolicyState = "disabled"
+	ConditionalAccessPolicyStateEnabledForReportingButNotEnforced ConditionalAccessPolicyState = "enabledForReportingButNotEnforced"
+)
+
+// ConditionalAccessConditionSet describes the conditions under which a ConditionalAccessPolicy is evaluated.
+type ConditionalAccessConditionSet struct {
+	ClientAppTypes   *[]string                      `json:"clientAppTypes,omitempty"`
+	SignInRiskLevels *[]string                      `json:"signInRiskLevels,omitempty"`
+	UserRiskLevels   *[]string                      `json:"userRiskLevels,omitempty"`
+	Applications     *ConditionalAccessApplications `json:"applications,omitempty"`
+	Users            *ConditionalAccessUsers        `json:"users,omitempty"`
+	Platforms        *ConditionalAccessPlatforms    `json:"platforms,omitempty"`
+	Locations        *ConditionalAccessLocations    `json:"locations,omitempty"`
+}
+
+// ConditionalAccessApplications describes the applications a ConditionalAccessPolicy applies to.
+type ConditionalAccessApplications struct {
+	IncludeApplications *[]string `json:"includeApplications,omitempty"`
+	ExcludeApplications *[]string `json:"excludeApplications,omitempty"`
+	IncludeUserActions  *[]string `json:"includeUserActions,omitempty"`
+}
+
+// ConditionalAccessUsers describes the users a ConditionalAccessPolicy applies to.
+type ConditionalAccessUsers struct {
+	IncludeUsers  *[]string `json:"includeUsers,omitempty"`
+	ExcludeUsers  *[]string `json:"excludeUsers,omitempty"`
+	IncludeGroups *[]string `json:"includeGroups,omitempty"`
+	ExcludeGroups *[]string `json:"excludeGroups,omitempty"`
+	IncludeRoles  *[]string `json:"includeRoles,omitempty"`
+	ExcludeRoles  *[]string `json:"excludeRoles,omitempty"`
+}
+
+// ConditionalAccessPlatforms describes the device platforms a ConditionalAccessPolicy applies to.
+type ConditionalAccessPlatforms struct {
+	IncludePlatforms *[]string `json:"includePlatforms,omitempty"`
+	ExcludePlatforms *[]string `json:"excludePlatforms,omitempty"`
+}
+
+// ConditionalAccessLocations describes the named locations a ConditionalAccessPolicy applies to.
+type ConditionalAccessLocations struct {
+	IncludeLocations *[]string `json:"includeLocations,omitempty"`
+	ExcludeLocations *[]string `json:"excludeLocations,omitempty"`
+}
+
+// ConditionalAccessGrantControls describes the grant controls enforced by a ConditionalAccessPolicy.
+type ConditionalAccessGrantControls struct {
+	Operator                    *string                       `json:"operator,omitempty"`
+	BuiltInControls             *[]string                     `json:"builtInControls,omitempty"`
+	CustomAuthenticationFactors *[]string                     `json:"customAuthenticationFactors,omitempty"`
+	TermsOfUse                  *[]string                     `json:"termsOfUse,omitempty"`
+	AuthenticationStrength      *AuthenticationStrengthPolicy `json:"authenticationStrength,omitempty"`
+}
+
+// ConditionalAccessSessionControls describes the session controls enforced by a ConditionalAccessPolicy.
+type ConditionalAccessSessionControls struct {
+	DisableResilienceDefaults *bool `json:"disableResilienceDefaults,omitempty"`
+}